@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"sync"
+	"time"
+
+	lrag "github.com/MegaGrindStone/go-light-rag"
+	vendorstorage "github.com/MegaGrindStone/go-light-rag/storage"
+	"github.com/fredrdz/go-light-rag-demo/internal/config"
+	lragllm "github.com/fredrdz/go-light-rag-demo/internal/llm"
+	lragstorage "github.com/fredrdz/go-light-rag-demo/internal/storage"
+	"github.com/fredrdz/go-light-rag-demo/internal/tools/secrets"
+)
+
+// llmClient holds a live LLM client that is rebuilt in place whenever its API
+// key rotates, so in-flight callers never see a stale key.
+type llmClient struct {
+	mu     sync.RWMutex
+	client lrag.LLM
+}
+
+// newLLMClient builds an llmClient for cfg.Backend and rebuilds it every time
+// cfg.APIKeySecret's value changes.
+func newLLMClient(cfg config.LLMConfig, manager *secrets.Manager, logger *slog.Logger) (*llmClient, error) {
+	c := &llmClient{}
+	if err := c.rebuild(cfg, manager, logger); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for range manager.Watch(cfg.APIKeySecret) {
+			if err := c.rebuild(cfg, manager, logger); err != nil {
+				logger.Error("failed to rebuild llm client after key rotation", "error", err)
+			}
+		}
+	}()
+
+	return c, nil
+}
+
+func (c *llmClient) rebuild(cfg config.LLMConfig, manager *secrets.Manager, logger *slog.Logger) error {
+	client, err := lragllm.Build(cfg, manager, logger)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.client = client
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Get returns the currently active LLM client.
+func (c *llmClient) Get() lrag.LLM {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.client
+}
+
+// graphClient holds a live graph store that is rebuilt in place whenever its
+// credentials rotate.
+type graphClient struct {
+	mu          sync.RWMutex
+	driver      vendorstorage.Neo4J
+	initialized bool
+}
+
+// newGraphClient builds a graphClient for cfg.Backend and rebuilds it every
+// time cfg.PasswordSecret's value changes.
+func newGraphClient(cfg config.GraphConfig, manager *secrets.Manager, logger *slog.Logger) (*graphClient, error) {
+	c := &graphClient{}
+	if err := c.rebuild(cfg, manager); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for range manager.Watch(cfg.PasswordSecret) {
+			if err := c.rebuild(cfg, manager); err != nil {
+				logger.Error("failed to rebuild graph store after credential rotation", "error", err)
+			}
+		}
+	}()
+
+	return c, nil
+}
+
+func (c *graphClient) rebuild(cfg config.GraphConfig, manager *secrets.Manager) error {
+	driver, err := lragstorage.BuildGraph(cfg, manager)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	old := c.driver
+	hadOld := c.initialized
+	c.driver = driver
+	c.initialized = true
+	c.mu.Unlock()
+
+	if !hadOld {
+		return nil
+	}
+
+	closeCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if cerr := old.Close(closeCtx); cerr != nil {
+		log.Printf("Error closing previous graph store: %v\n", cerr)
+	}
+
+	return nil
+}
+
+// Get returns the currently active graph store.
+func (c *graphClient) Get() vendorstorage.Neo4J {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.driver
+}
+
+// Close closes the currently active graph store.
+func (c *graphClient) Close() error {
+	closeCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return c.Get().Close(closeCtx)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/fredrdz/go-light-rag-demo/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// runConfigCommand dispatches `lrag config <subcommand> [path]`.
+func runConfigCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: lrag config <validate|print> [path]")
+	}
+
+	path := defaultConfigPath
+	if len(args) > 1 {
+		path = args[1]
+	}
+
+	switch args[0] {
+	case "validate":
+		return runConfigValidate(path)
+	case "print":
+		return runConfigPrint(path)
+	default:
+		return fmt.Errorf("unknown config subcommand %q", args[0])
+	}
+}
+
+// runConfigValidate loads the config at path and checks it for completeness.
+// This only catches missing backend names, not an unbuildable one (e.g. a
+// backend that's advertised in a doc comment but has no registered factory
+// yet) — that only surfaces once cmd/cli actually builds the backend.
+func runConfigValidate(path string) error {
+	cfg, err := config.Load(path)
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is valid\n", path)
+	return nil
+}
+
+// runConfigPrint prints the config at path as YAML, or the schema-driven
+// default config if path does not exist.
+func runConfigPrint(path string) error {
+	cfg, err := config.Load(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		cfg = config.Default()
+	}
+
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("error marshaling config: %w", err)
+	}
+
+	fmt.Print(string(out))
+	return nil
+}
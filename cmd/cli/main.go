@@ -1,78 +1,93 @@
 // main.go
-// OpenAI LLM + ChromeM (vector) + BoltDB (KV) + MemGraph (graph)
+// Backends are selected by lrag.yaml (see internal/config); defaults to
+// OpenAI LLM + Neo4j (graph) + ChromeM (vector) + BoltDB (KV).
 package main
 
 import (
 	"context"
 	"fmt"
-	"log"
 	"log/slog"
 	"os"
 	"time"
 
 	lrag "github.com/MegaGrindStone/go-light-rag"
 	"github.com/MegaGrindStone/go-light-rag/handler"
-	"github.com/MegaGrindStone/go-light-rag/llm"
-	"github.com/MegaGrindStone/go-light-rag/storage"
-	"github.com/philippgille/chromem-go"
+	vendorstorage "github.com/MegaGrindStone/go-light-rag/storage"
+	"github.com/fredrdz/go-light-rag-demo/internal/config"
+	lragstorage "github.com/fredrdz/go-light-rag-demo/internal/storage"
+	"github.com/fredrdz/go-light-rag-demo/internal/tools/secrets"
 )
 
+const defaultConfigPath = "lrag.yaml"
+
 type store struct {
-	storage.Neo4J
-	storage.Chromem
-	storage.Bolt
+	vendorstorage.Neo4J
+	vendorstorage.Chromem
+	vendorstorage.Bolt
 }
 
 func main() {
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{}))
 
-	// llm: OpenAI
-	openai := llm.NewOpenAI(
-		os.Getenv("OPENAI_API_KEY"),
-		"gpt-5-nano", // https://platform.openai.com/docs/pricing
-		llm.Parameters{},
-		logger,
-	)
-
-	// graph: Neo4j
-	graph, err := storage.NewNeo4J(
-		"bolt://localhost:7687",
-		"",
-		"")
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		if err := runConfigCommand(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	ctx := context.Background()
+
+	cfg, err := config.LoadOrDefault(defaultConfigPath)
 	if err != nil {
 		panic(err)
 	}
 
-	defer func() {
-		closeCtx, closeCancel := context.WithTimeout(context.Background(), time.Second*30)
-		defer closeCancel()
+	// secrets: fetched once, then refreshed on SECRETS_REFRESH so key rotation
+	// doesn't require a restart.
+	manager, err := secrets.NewManagerFromEnv(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer manager.Close()
+
+	// llm: selected by cfg.LLM.Backend, rebuilt whenever its API key rotates
+	llmClient, err := newLLMClient(cfg.LLM, manager, logger)
+	if err != nil {
+		panic(err)
+	}
 
-		if err := graph.Close(closeCtx); err != nil {
-			log.Printf("Error closing neo4jDB: %v\n", err)
+	// graph: selected by cfg.Graph.Backend, rebuilt whenever its credentials rotate
+	graph, err := newGraphClient(cfg.Graph, manager, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := graph.Close(); err != nil {
+			logger.Error("error closing graph store", "error", err)
 		}
 	}()
 
-	// vector: ChromeM using OpenAI embeddings (or use chromem.NewEmbeddingFuncDefault()).
-	emb := chromem.NewEmbeddingFuncOpenAI(
-		os.Getenv("OPENAI_API_KEY"), chromem.EmbeddingModelOpenAI3Small) // chromem EmbeddingFunc
-
-	vec,
-		err := storage.NewChromem(
-		"tmp/vec.db",
-		5,
-		storage.EmbeddingFunc(emb),
-	) // file-backed, no server
+	// vector: selected by cfg.Vector.Backend
+	vec, err := lragstorage.BuildVector(cfg.Vector, manager)
 	if err != nil {
 		panic(err)
 	}
 
-	// kv: BoltDB
-	kv, err := storage.NewBolt("tmp/kv.db") // file-backed, no server
+	// kv: selected by cfg.KV.Backend
+	kv, err := lragstorage.BuildKV(cfg.KV, manager)
 	if err != nil {
 		panic(err)
 	}
 
-	st := store{Neo4J: graph, Chromem: vec, Bolt: kv}
+	// newStore is re-evaluated at every call site below so a graph credential
+	// rotation (which swaps graphClient's driver) is picked up immediately,
+	// the same way llmClient.Get() is re-read at each call.
+	newStore := func() store {
+		return store{Neo4J: graph.Get(), Chromem: vec, Bolt: kv}
+	}
+
 	h := handler.Default{
 		ChunkMaxTokenSize: 1500,
 		EntityTypes: []string{
@@ -91,7 +106,7 @@ func main() {
 		Content: "Neo4j stores entities; ChromeM stores vectors; Bolt stores chunks.",
 	}
 
-	err = lrag.Insert(doc, h, st, openai, logger)
+	err = lrag.Insert(doc, h, newStore(), llmClient.Get(), logger)
 	if err != nil {
 		panic(err)
 	}
@@ -104,7 +119,7 @@ func main() {
 		},
 	}
 
-	result, err := lrag.Query(q, h, st, openai, logger)
+	result, err := lrag.Query(q, h, newStore(), llmClient.Get(), logger)
 	if err != nil {
 		panic(err)
 	}
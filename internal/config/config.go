@@ -0,0 +1,157 @@
+// Package config loads the lrag.yaml (or .toml) file that selects which LLM,
+// graph, vector, and KV backends cmd/cli wires up, so adding a backend is a
+// matter of registering a factory rather than editing main.go.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes the full set of backends a running lrag instance should use.
+type Config struct {
+	LLM    LLMConfig    `yaml:"llm"    toml:"llm"`
+	Graph  GraphConfig  `yaml:"graph"  toml:"graph"`
+	Vector VectorConfig `yaml:"vector" toml:"vector"`
+	KV     KVConfig     `yaml:"kv"     toml:"kv"`
+}
+
+// LLMConfig selects and configures the LLM backend, e.g. "openai", "anthropic", "ollama".
+type LLMConfig struct {
+	Backend      string `yaml:"backend"                toml:"backend"`
+	Model        string `yaml:"model"                  toml:"model"`
+	Endpoint     string `yaml:"endpoint,omitempty"      toml:"endpoint,omitempty"`
+	APIKeySecret string `yaml:"apiKeySecret"            toml:"apiKeySecret"`
+}
+
+// GraphConfig selects and configures the graph store backend, e.g. "neo4j", "memgraph", "memory".
+type GraphConfig struct {
+	Backend        string `yaml:"backend"                  toml:"backend"`
+	Endpoint       string `yaml:"endpoint,omitempty"        toml:"endpoint,omitempty"`
+	UserSecret     string `yaml:"userSecret,omitempty"      toml:"userSecret,omitempty"`
+	PasswordSecret string `yaml:"passwordSecret,omitempty"  toml:"passwordSecret,omitempty"`
+}
+
+// VectorConfig selects and configures the vector store backend, e.g. "chromem", "qdrant", "pgvector".
+type VectorConfig struct {
+	Backend      string `yaml:"backend"               toml:"backend"`
+	Path         string `yaml:"path,omitempty"        toml:"path,omitempty"`
+	Endpoint     string `yaml:"endpoint,omitempty"     toml:"endpoint,omitempty"`
+	TopK         int    `yaml:"topK"                   toml:"topK"`
+	APIKeySecret string `yaml:"apiKeySecret,omitempty" toml:"apiKeySecret,omitempty"`
+}
+
+// KVConfig selects and configures the KV store backend, e.g. "bolt", "badger", "redis".
+type KVConfig struct {
+	Backend  string `yaml:"backend"           toml:"backend"`
+	Path     string `yaml:"path,omitempty"     toml:"path,omitempty"`
+	Endpoint string `yaml:"endpoint,omitempty" toml:"endpoint,omitempty"`
+}
+
+// Default returns the configuration equivalent to cmd/cli's original
+// hard-coded OpenAI + Neo4j + Chromem + Bolt wiring.
+func Default() *Config {
+	return &Config{
+		LLM: LLMConfig{
+			Backend:      "openai",
+			Model:        "gpt-5-nano", // https://platform.openai.com/docs/pricing
+			APIKeySecret: "OPENAI_API_KEY",
+		},
+		Graph: GraphConfig{
+			Backend:        "neo4j",
+			Endpoint:       "bolt://localhost:7687",
+			UserSecret:     "NEO4J_USER",
+			PasswordSecret: "NEO4J_PASSWORD",
+		},
+		Vector: VectorConfig{
+			Backend:      "chromem",
+			Path:         "tmp/vec.db",
+			TopK:         5,
+			APIKeySecret: "OPENAI_API_KEY",
+		},
+		KV: KVConfig{
+			Backend: "bolt",
+			Path:    "tmp/kv.db",
+		},
+	}
+}
+
+// Load reads and parses the config file at path, dispatching on its
+// extension (.toml, otherwise YAML).
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: error reading %s: %w", path, err)
+	}
+
+	cfg := &Config{}
+
+	if strings.EqualFold(ext(path), ".toml") {
+		if err := toml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("config: error parsing %s: %w", path, err)
+		}
+		return cfg, nil
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("config: error parsing %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// LoadOrDefault loads the config file at path, falling back to Default if it
+// does not exist.
+func LoadOrDefault(path string) (*Config, error) {
+	cfg, err := Load(path)
+	if err == nil {
+		return cfg, nil
+	}
+
+	if errors.Is(err, os.ErrNotExist) {
+		return Default(), nil
+	}
+
+	return nil, err
+}
+
+// Validate checks that every backend names a non-empty value; it does not
+// check that the named backend is actually registered or buildable (e.g.
+// "memgraph", "qdrant", and other advertised-but-not-yet-implemented names
+// pass Validate and only fail once the matching registry's Build is called,
+// since the registries are the only place that knows what's registered).
+func (c *Config) Validate() error {
+	var missing []string
+
+	if c.LLM.Backend == "" {
+		missing = append(missing, "llm.backend")
+	}
+	if c.Graph.Backend == "" {
+		missing = append(missing, "graph.backend")
+	}
+	if c.Vector.Backend == "" {
+		missing = append(missing, "vector.backend")
+	}
+	if c.KV.Backend == "" {
+		missing = append(missing, "kv.backend")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("config: missing required field(s): %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func ext(path string) string {
+	i := strings.LastIndexByte(path, '.')
+	if i < 0 {
+		return ""
+	}
+	return path[i:]
+}
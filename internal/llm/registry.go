@@ -0,0 +1,55 @@
+// Package llm builds the lrag.LLM client cmd/cli needs from a config.Config,
+// via a backend registry. Adding a new backend is a matter of calling
+// Register from an init function; main.go never changes.
+package llm
+
+import (
+	"fmt"
+	"log/slog"
+
+	lrag "github.com/MegaGrindStone/go-light-rag"
+	vendor "github.com/MegaGrindStone/go-light-rag/llm"
+	"github.com/fredrdz/go-light-rag-demo/internal/config"
+	"github.com/fredrdz/go-light-rag-demo/internal/tools/secrets"
+)
+
+// Factory builds an LLM client from its config and the running secrets Manager.
+type Factory func(cfg config.LLMConfig, manager *secrets.Manager, logger *slog.Logger) (lrag.LLM, error)
+
+var factories = map[string]Factory{}
+
+// Register makes an LLM backend available under name, e.g. "openai".
+func Register(name string, factory Factory) {
+	factories[name] = factory
+}
+
+// Build constructs the LLM client named by cfg.Backend.
+func Build(cfg config.LLMConfig, manager *secrets.Manager, logger *slog.Logger) (lrag.LLM, error) {
+	factory, ok := factories[cfg.Backend]
+	if !ok {
+		return nil, fmt.Errorf("llm: unknown backend %q", cfg.Backend)
+	}
+	return factory(cfg, manager, logger)
+}
+
+func init() {
+	Register("openai", newOpenAI)
+	Register("anthropic", newAnthropic)
+	Register("ollama", newOllama)
+}
+
+func newOpenAI(cfg config.LLMConfig, manager *secrets.Manager, logger *slog.Logger) (lrag.LLM, error) {
+	apiKey, _ := manager.Get(cfg.APIKeySecret)
+
+	return vendor.NewOpenAI(apiKey, cfg.Model, vendor.Parameters{}, logger), nil
+}
+
+func newAnthropic(cfg config.LLMConfig, manager *secrets.Manager, logger *slog.Logger) (lrag.LLM, error) {
+	apiKey, _ := manager.Get(cfg.APIKeySecret)
+
+	return vendor.NewAnthropic(apiKey, cfg.Model, vendor.Parameters{}, logger), nil
+}
+
+func newOllama(cfg config.LLMConfig, _ *secrets.Manager, logger *slog.Logger) (lrag.LLM, error) {
+	return vendor.NewOllama(cfg.Endpoint, cfg.Model, vendor.Parameters{}, logger), nil
+}
@@ -0,0 +1,128 @@
+// Package storage builds the graph, vector, and KV stores cmd/cli needs from
+// a config.Config, via backend registries. Adding a new backend is a matter
+// of calling Register* from an init function; main.go never changes.
+package storage
+
+import (
+	"fmt"
+
+	vendor "github.com/MegaGrindStone/go-light-rag/storage"
+	"github.com/fredrdz/go-light-rag-demo/internal/config"
+	"github.com/fredrdz/go-light-rag-demo/internal/tools/secrets"
+	"github.com/philippgille/chromem-go"
+)
+
+// GraphFactory builds a graph store from its config and the running secrets Manager.
+type GraphFactory func(cfg config.GraphConfig, manager *secrets.Manager) (vendor.Neo4J, error)
+
+// VectorFactory builds a vector store from its config and the running secrets Manager.
+type VectorFactory func(cfg config.VectorConfig, manager *secrets.Manager) (vendor.Chromem, error)
+
+// KVFactory builds a KV store from its config and the running secrets Manager.
+type KVFactory func(cfg config.KVConfig, manager *secrets.Manager) (vendor.Bolt, error)
+
+var (
+	graphFactories  = map[string]GraphFactory{}
+	vectorFactories = map[string]VectorFactory{}
+	kvFactories     = map[string]KVFactory{}
+)
+
+// RegisterGraph makes a graph backend available under name, e.g. "neo4j".
+func RegisterGraph(name string, factory GraphFactory) {
+	graphFactories[name] = factory
+}
+
+// RegisterVector makes a vector backend available under name, e.g. "chromem".
+func RegisterVector(name string, factory VectorFactory) {
+	vectorFactories[name] = factory
+}
+
+// RegisterKV makes a KV backend available under name, e.g. "bolt".
+func RegisterKV(name string, factory KVFactory) {
+	kvFactories[name] = factory
+}
+
+// BuildGraph constructs the graph store named by cfg.Backend.
+func BuildGraph(cfg config.GraphConfig, manager *secrets.Manager) (vendor.Neo4J, error) {
+	factory, ok := graphFactories[cfg.Backend]
+	if !ok {
+		return vendor.Neo4J{}, fmt.Errorf("storage: unknown graph backend %q", cfg.Backend)
+	}
+	return factory(cfg, manager)
+}
+
+// BuildVector constructs the vector store named by cfg.Backend.
+func BuildVector(cfg config.VectorConfig, manager *secrets.Manager) (vendor.Chromem, error) {
+	factory, ok := vectorFactories[cfg.Backend]
+	if !ok {
+		return vendor.Chromem{}, fmt.Errorf("storage: unknown vector backend %q", cfg.Backend)
+	}
+	return factory(cfg, manager)
+}
+
+// BuildKV constructs the KV store named by cfg.Backend.
+func BuildKV(cfg config.KVConfig, manager *secrets.Manager) (vendor.Bolt, error) {
+	factory, ok := kvFactories[cfg.Backend]
+	if !ok {
+		return vendor.Bolt{}, fmt.Errorf("storage: unknown kv backend %q", cfg.Backend)
+	}
+	return factory(cfg, manager)
+}
+
+func init() {
+	RegisterGraph("neo4j", newNeo4JGraph)
+	RegisterGraph("memgraph", unsupportedGraph("memgraph"))
+	RegisterGraph("memory", unsupportedGraph("memory"))
+
+	RegisterVector("chromem", newChromemVector)
+	RegisterVector("qdrant", unsupportedVector("qdrant"))
+	RegisterVector("pgvector", unsupportedVector("pgvector"))
+
+	RegisterKV("bolt", newBoltKV)
+	RegisterKV("badger", unsupportedKV("badger"))
+	RegisterKV("redis", unsupportedKV("redis"))
+}
+
+func newNeo4JGraph(cfg config.GraphConfig, manager *secrets.Manager) (vendor.Neo4J, error) {
+	user, _ := manager.Get(cfg.UserSecret)
+	password, _ := manager.Get(cfg.PasswordSecret)
+
+	return vendor.NewNeo4J(cfg.Endpoint, user, password)
+}
+
+func newChromemVector(cfg config.VectorConfig, manager *secrets.Manager) (vendor.Chromem, error) {
+	apiKey, _ := manager.Get(cfg.APIKeySecret)
+	emb := chromem.NewEmbeddingFuncOpenAI(apiKey, chromem.EmbeddingModelOpenAI3Small)
+
+	topK := cfg.TopK
+	if topK == 0 {
+		topK = 5
+	}
+
+	return vendor.NewChromem(cfg.Path, topK, vendor.EmbeddingFunc(emb))
+}
+
+func newBoltKV(cfg config.KVConfig, _ *secrets.Manager) (vendor.Bolt, error) {
+	return vendor.NewBolt(cfg.Path)
+}
+
+// unsupportedGraph returns a GraphFactory for a backend name this repo
+// doesn't yet vendor a driver for; it exists so Config.Validate and `lrag
+// config print` recognize the name, without pretending to support it.
+func unsupportedGraph(name string) GraphFactory {
+	return func(config.GraphConfig, *secrets.Manager) (vendor.Neo4J, error) {
+		return vendor.Neo4J{}, fmt.Errorf("storage: graph backend %q is not yet implemented", name)
+	}
+}
+
+func unsupportedVector(name string) VectorFactory {
+	return func(config.VectorConfig, *secrets.Manager) (vendor.Chromem, error) {
+		return vendor.Chromem{}, fmt.Errorf("storage: vector backend %q is not yet implemented", name)
+	}
+}
+
+func unsupportedKV(name string) KVFactory {
+	return func(config.KVConfig, *secrets.Manager) (vendor.Bolt, error) {
+		return vendor.Bolt{}, fmt.Errorf("storage: kv backend %q is not yet implemented", name)
+	}
+}
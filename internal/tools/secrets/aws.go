@@ -0,0 +1,103 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// AWSProvider fetches secrets from AWS Secrets Manager by listing secret
+// names and then batch-getting their values.
+type AWSProvider struct {
+	region string
+	prefix string
+}
+
+// NewAWSProviderFromEnv builds an AWSProvider from AWS_REGION and the
+// optional AWS_SECRETS_PREFIX filter. Credentials are resolved through the
+// default AWS SDK credential chain.
+func NewAWSProviderFromEnv() (*AWSProvider, error) {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		return nil, errors.New("AWS_REGION must be set")
+	}
+
+	return &AWSProvider{region: region, prefix: os.Getenv("AWS_SECRETS_PREFIX")}, nil
+}
+
+// Name implements Provider.
+func (p *AWSProvider) Name() string {
+	return "aws"
+}
+
+// Fetch implements Provider.
+func (p *AWSProvider) Fetch(ctx context.Context) ([]Secret, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(p.region))
+	if err != nil {
+		return nil, fmt.Errorf("error loading aws config: %w", err)
+	}
+
+	client := secretsmanager.NewFromConfig(cfg)
+
+	var names []string
+	paginator := secretsmanager.NewListSecretsPaginator(client, &secretsmanager.ListSecretsInput{})
+	for paginator.HasMorePages() {
+		page, perr := paginator.NextPage(ctx)
+		if perr != nil {
+			return nil, fmt.Errorf("error listing aws secrets: %w", perr)
+		}
+
+		for _, s := range page.SecretList {
+			if s.Name == nil {
+				continue
+			}
+			if p.prefix != "" && !strings.HasPrefix(*s.Name, p.prefix) {
+				continue
+			}
+			names = append(names, *s.Name)
+		}
+	}
+
+	out := make([]Secret, 0, len(names))
+	for _, batch := range chunkStrings(names, batchGetSecretValueLimit) {
+		resp, err := client.BatchGetSecretValue(ctx, &secretsmanager.BatchGetSecretValueInput{
+			SecretIdList: batch,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving aws secrets: %w", err)
+		}
+
+		for _, v := range resp.SecretValues {
+			if v.Name == nil || v.SecretString == nil {
+				continue
+			}
+			key := strings.TrimPrefix(*v.Name, p.prefix)
+			out = append(out, Secret{Key: key, Value: *v.SecretString})
+		}
+	}
+
+	return out, nil
+}
+
+// batchGetSecretValueLimit is the maximum number of secret IDs AWS accepts
+// in a single BatchGetSecretValue request.
+const batchGetSecretValueLimit = 20
+
+// chunkStrings splits names into slices of at most size entries.
+func chunkStrings(names []string, size int) [][]string {
+	var chunks [][]string
+	for len(names) > 0 {
+		end := size
+		if end > len(names) {
+			end = len(names)
+		}
+		chunks = append(chunks, names[:end])
+		names = names[end:]
+	}
+	return chunks
+}
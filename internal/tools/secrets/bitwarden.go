@@ -0,0 +1,77 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	bws "github.com/bitwarden/sdk-go"
+	"github.com/google/uuid"
+)
+
+// BitwardenProvider fetches secrets from a Bitwarden Secrets Manager organization.
+type BitwardenProvider struct {
+	accessToken    string
+	organizationID string
+}
+
+// NewBitwardenProviderFromEnv builds a BitwardenProvider from ACCESS_TOKEN and
+// ORGANIZATION_ID.
+func NewBitwardenProviderFromEnv() (*BitwardenProvider, error) {
+	accessToken := os.Getenv("ACCESS_TOKEN")
+	organizationID := os.Getenv("ORGANIZATION_ID")
+
+	if accessToken == "" || organizationID == "" {
+		return nil, errors.New("ACCESS_TOKEN and ORGANIZATION_ID must be set")
+	}
+
+	if _, err := uuid.Parse(organizationID); err != nil {
+		return nil, fmt.Errorf("invalid uuid: %w", err)
+	}
+
+	return &BitwardenProvider{accessToken: accessToken, organizationID: organizationID}, nil
+}
+
+// Name implements Provider.
+func (p *BitwardenProvider) Name() string {
+	return "bitwarden"
+}
+
+// Fetch implements Provider.
+func (p *BitwardenProvider) Fetch(_ context.Context) ([]Secret, error) {
+	client, err := bws.NewBitwardenClient(nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating bitwarden client: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.AccessTokenLogin(p.accessToken, nil); err != nil {
+		return nil, fmt.Errorf("error logging in with access token: %w", err)
+	}
+
+	secretIdentifiers, err := client.Secrets().List(p.organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing secrets: %w", err)
+	}
+
+	var secretIDs []string
+	for _, identifier := range secretIdentifiers.Data {
+		secretIDs = append(secretIDs, identifier.ID)
+	}
+
+	resp, err := client.Secrets().GetByIDS(secretIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving secrets: %w", err)
+	}
+
+	out := make([]Secret, 0, len(resp.Data))
+	for _, s := range resp.Data {
+		if s.Key == "" {
+			continue
+		}
+		out = append(out, Secret{Key: s.Key, Value: s.Value})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,46 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"strings"
+)
+
+// EnvProvider passes through whatever secrets are already exported in the
+// process environment, optionally filtered by ENV_SECRETS_PREFIX. It is the
+// default provider, so a demo or dev run with e.g. just OPENAI_API_KEY set
+// in its environment keeps working without any SECRETS_PROVIDER configuration.
+type EnvProvider struct {
+	prefix string
+}
+
+// NewEnvProviderFromEnv builds an EnvProvider, optionally restricted to keys
+// starting with ENV_SECRETS_PREFIX.
+func NewEnvProviderFromEnv() (*EnvProvider, error) {
+	return &EnvProvider{prefix: os.Getenv("ENV_SECRETS_PREFIX")}, nil
+}
+
+// Name implements Provider.
+func (p *EnvProvider) Name() string {
+	return "env"
+}
+
+// Fetch implements Provider.
+func (p *EnvProvider) Fetch(_ context.Context) ([]Secret, error) {
+	environ := os.Environ()
+	out := make([]Secret, 0, len(environ))
+
+	for _, kv := range environ {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		if p.prefix != "" && !strings.HasPrefix(key, p.prefix) {
+			continue
+		}
+
+		out = append(out, Secret{Key: key, Value: value})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FileProvider reads secrets from a local .env or JSON file, for development.
+type FileProvider struct {
+	path string
+}
+
+// NewFileProviderFromEnv builds a FileProvider from SECRETS_FILE_PATH,
+// defaulting to ".env" in the current directory.
+func NewFileProviderFromEnv() (*FileProvider, error) {
+	path := os.Getenv("SECRETS_FILE_PATH")
+	if path == "" {
+		path = ".env"
+	}
+
+	return &FileProvider{path: path}, nil
+}
+
+// Name implements Provider.
+func (p *FileProvider) Name() string {
+	return "file"
+}
+
+// Fetch implements Provider.
+func (p *FileProvider) Fetch(_ context.Context) ([]Secret, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secrets file %s: %w", p.path, err)
+	}
+
+	if strings.HasSuffix(p.path, ".json") {
+		return parseJSONSecrets(data)
+	}
+
+	return parseEnvSecrets(data)
+}
+
+// parseJSONSecrets parses a flat JSON object of string key/value pairs.
+func parseJSONSecrets(data []byte) ([]Secret, error) {
+	var values map[string]string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("error parsing secrets json: %w", err)
+	}
+
+	out := make([]Secret, 0, len(values))
+	for k, v := range values {
+		out = append(out, Secret{Key: k, Value: v})
+	}
+
+	return out, nil
+}
+
+// parseEnvSecrets parses KEY=value lines in the style of a .env file,
+// ignoring blank lines, "#" comments, and a leading "export ".
+func parseEnvSecrets(data []byte) ([]Secret, error) {
+	var out []Secret
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if key == "" {
+			continue
+		}
+
+		out = append(out, Secret{Key: key, Value: value})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error scanning secrets file: %w", err)
+	}
+
+	return out, nil
+}
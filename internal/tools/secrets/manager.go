@@ -0,0 +1,223 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+)
+
+const defaultRefreshInterval = 5 * time.Minute
+
+// Manager fetches secrets from a set of providers once, caches them in
+// memory, and refreshes them on a jittered interval so a long-lived process
+// can observe key rotation without restarting.
+type Manager struct {
+	providers []Provider
+	interval  time.Duration
+	sink      Sink
+
+	mu       sync.RWMutex
+	values   map[string]string
+	watchers map[string][]chan string
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewManagerFromEnv builds a Manager using the provider(s) named by
+// SECRETS_PROVIDER and the refresh interval named by SECRETS_REFRESH
+// (default 5m, e.g. "30s", "5m", "1h"). It performs an initial fetch before
+// returning, then refreshes in the background until Close is called.
+func NewManagerFromEnv(ctx context.Context) (*Manager, error) {
+	providers, err := ProvidersFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	interval := defaultRefreshInterval
+	if v := os.Getenv("SECRETS_REFRESH"); v != "" {
+		interval, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("secrets: invalid SECRETS_REFRESH %q: %w", v, err)
+		}
+	}
+
+	return NewManager(ctx, providers, interval)
+}
+
+// NewManager builds a Manager over providers, refreshing every interval. It
+// performs an initial fetch before returning, then refreshes in the
+// background until Close is called. Legacy callers that still want secrets
+// mirrored into the process environment can opt in with Manager.Sink.
+func NewManager(ctx context.Context, providers []Provider, interval time.Duration) (*Manager, error) {
+	m := &Manager{
+		providers: providers,
+		interval:  interval,
+		values:    make(map[string]string),
+		watchers:  make(map[string][]chan string),
+		done:      make(chan struct{}),
+	}
+
+	if err := m.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	go m.refreshLoop(ctx)
+
+	return m, nil
+}
+
+// Sink opts the Manager into mirroring every refreshed secret to sink, e.g.
+// EnvSink for legacy callers that still read from the process environment.
+func (m *Manager) Sink(sink Sink) {
+	m.mu.Lock()
+	m.sink = sink
+	m.mu.Unlock()
+}
+
+// Get returns the current cached value for key, and whether it was found.
+func (m *Manager) Get(key string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// Watch returns a channel that receives key's new value every time a refresh
+// observes it changing. The channel is closed when the Manager is closed.
+func (m *Manager) Watch(key string) <-chan string {
+	ch := make(chan string, 1)
+
+	m.mu.Lock()
+	m.watchers[key] = append(m.watchers[key], ch)
+	m.mu.Unlock()
+
+	return ch
+}
+
+// Close stops the background refresh loop and closes all Watch channels.
+func (m *Manager) Close() error {
+	m.closeOnce.Do(func() {
+		close(m.done)
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, chans := range m.watchers {
+			for _, ch := range chans {
+				close(ch)
+			}
+		}
+	})
+
+	return nil
+}
+
+// refreshLoop periodically re-fetches secrets until Close is called or ctx
+// is done, backing off with jitter on transient provider errors.
+func (m *Manager) refreshLoop(ctx context.Context) {
+	for {
+		if !m.sleep(ctx, jitter(m.interval)) {
+			return
+		}
+
+		backoff := time.Second
+		for {
+			if err := m.refresh(ctx); err == nil {
+				break
+			}
+
+			if !m.sleep(ctx, jitter(backoff)) {
+				return
+			}
+
+			backoff *= 2
+			if backoff > time.Minute {
+				backoff = time.Minute
+			}
+		}
+	}
+}
+
+// sleep waits for d, returning false if the Manager was closed or ctx was
+// cancelled first.
+func (m *Manager) sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-m.done:
+		return false
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// refresh fetches secrets from every provider, merges them in order, and
+// notifies watchers and the configured sink of anything that changed.
+func (m *Manager) refresh(ctx context.Context) error {
+	fresh := make(map[string]string)
+
+	for _, provider := range m.providers {
+		fetched, err := provider.Fetch(ctx)
+		if err != nil {
+			return fmt.Errorf("secrets: %s: %w", provider.Name(), err)
+		}
+
+		for _, s := range fetched {
+			if s.Key == "" {
+				continue
+			}
+			fresh[s.Key] = s.Value
+		}
+	}
+
+	m.mu.Lock()
+	changed := make(map[string]string)
+	for k, v := range fresh {
+		if old, ok := m.values[k]; !ok || old != v {
+			changed[k] = v
+		}
+	}
+	m.values = fresh
+	sink := m.sink
+	m.mu.Unlock()
+
+	for k, v := range changed {
+		if sink != nil {
+			if err := sink.Set(k, v); err != nil {
+				return fmt.Errorf("secrets: error setting %s: %w", k, err)
+			}
+		}
+		m.notify(k, v)
+	}
+
+	return nil
+}
+
+// notify delivers value to every Watch channel registered for key, dropping
+// the update if a channel's single-slot buffer is already full.
+func (m *Manager) notify(key, value string) {
+	m.mu.RLock()
+	chans := m.watchers[key]
+	m.mu.RUnlock()
+
+	for _, ch := range chans {
+		select {
+		case ch <- value:
+		default:
+		}
+	}
+}
+
+// jitter returns a random duration in [d/2, 3d/2), to spread out refreshes
+// and retries across many processes.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d)))
+}
@@ -1,111 +1,120 @@
-// Package secrets provides functionality to load secrets from Bitwarden
-// and set them as environment variables.
+// Package secrets loads application secrets from one or more pluggable
+// backends (the process environment, Bitwarden Secrets Manager, HashiCorp
+// Vault, AWS Secrets Manager, or a local .env/JSON file) and hands them to a
+// pluggable sink.
 package secrets
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"os"
-
-	bws "github.com/bitwarden/sdk-go"
-	"github.com/google/uuid"
+	"strings"
 )
 
-// Load orchestrates the fetching and setting of secrets.
-func Load() error {
-	accessToken, organizationID, err := LoadConfig()
-	if err != nil {
-		return err
-	}
-
-	client, err := NewBitwardenClient()
-	if err != nil {
-		return err
-	}
-	defer client.Close()
-
-	if aerr := Authenticate(client, accessToken); aerr != nil {
-		return aerr
-	}
+// Secret is a single resolved key/value pair, regardless of which Provider produced it.
+type Secret struct {
+	Key   string
+	Value string
+}
 
-	secrets, err := FetchSecrets(client, organizationID)
-	if err != nil {
-		return err
-	}
+// Provider fetches the secrets available from a single backend.
+type Provider interface {
+	// Name identifies the provider in errors and logs, e.g. "vault".
+	Name() string
+	// Fetch retrieves every secret currently available from the backend.
+	Fetch(ctx context.Context) ([]Secret, error)
+}
 
-	if err := SetEnvironmentVariables(secrets); err != nil {
-		return err
-	}
+// Sink receives resolved secrets, one key/value pair at a time.
+type Sink interface {
+	Set(key, value string) error
+}
 
-	return nil
+// providerFactories maps a SECRETS_PROVIDER name to a constructor that reads
+// that provider's configuration from the environment.
+var providerFactories = map[string]func() (Provider, error){
+	"env":       func() (Provider, error) { return NewEnvProviderFromEnv() },
+	"bitwarden": func() (Provider, error) { return NewBitwardenProviderFromEnv() },
+	"vault":     func() (Provider, error) { return NewVaultProviderFromEnv() },
+	"aws":       func() (Provider, error) { return NewAWSProviderFromEnv() },
+	"file":      func() (Provider, error) { return NewFileProviderFromEnv() },
 }
 
-// ----------------------------------------------------------------
+// ProvidersFromEnv builds the ordered list of providers requested via
+// SECRETS_PROVIDER, a comma-separated list of provider names (default
+// "env", which just passes through the process environment so a plain
+// `OPENAI_API_KEY=... lrag` run keeps working with no further setup).
+// Providers are returned in the order given; callers should apply their
+// secrets in that order so later providers override earlier ones.
+func ProvidersFromEnv() ([]Provider, error) {
+	spec := os.Getenv("SECRETS_PROVIDER")
+	if spec == "" {
+		spec = "env"
+	}
 
-// LoadConfig retrieves required configuration from environment variables.
-func LoadConfig() (string, string, error) {
-	accessToken := os.Getenv("ACCESS_TOKEN")
-	organizationID := os.Getenv("ORGANIZATION_ID")
+	names := strings.Split(spec, ",")
+	providers := make([]Provider, 0, len(names))
 
-	if accessToken == "" || organizationID == "" {
-		return "", "", errors.New("ACCESS_TOKEN and ORGANIZATION_ID must be set")
-	}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
 
-	if _, err := uuid.Parse(organizationID); err != nil {
-		return "", "", fmt.Errorf("invalid uuid: %w", err)
-	}
+		factory, ok := providerFactories[name]
+		if !ok {
+			return nil, fmt.Errorf("secrets: unknown provider %q", name)
+		}
 
-	return accessToken, organizationID, nil
-}
+		provider, err := factory()
+		if err != nil {
+			return nil, fmt.Errorf("secrets: %s: %w", name, err)
+		}
 
-// NewBitwardenClient initializes and returns a Bitwarden client.
-func NewBitwardenClient() (bws.BitwardenClientInterface, error) {
-	client, err := bws.NewBitwardenClient(nil, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error creating bitwarden client: %w", err)
+		providers = append(providers, provider)
 	}
 
-	return client, nil
+	return providers, nil
 }
 
-// Authenticate logs in to Bitwarden using an access token.
-func Authenticate(client bws.BitwardenClientInterface, accessToken string) error {
-	if err := client.AccessTokenLogin(accessToken, nil); err != nil {
-		return fmt.Errorf("error logging in with access token: %w", err)
-	}
-
-	return nil
+// Load fetches secrets from the provider(s) named by SECRETS_PROVIDER and
+// sets them as environment variables. It is kept for callers that have not
+// yet migrated to an explicit Sink; new code should prefer LoadInto.
+func Load() error {
+	return LoadInto(context.Background(), EnvSink{})
 }
 
-// FetchSecrets retrieves all secrets for the given organization.
-func FetchSecrets(client bws.BitwardenClientInterface, organizationID string) (*bws.SecretsResponse, error) {
-	secretIdentifiers, err := client.Secrets().List(organizationID)
+// LoadInto fetches secrets from the provider(s) named by SECRETS_PROVIDER and
+// writes each resolved key/value pair to sink.
+func LoadInto(ctx context.Context, sink Sink) error {
+	providers, err := ProvidersFromEnv()
 	if err != nil {
-		return nil, fmt.Errorf("error listing secrets: %w", err)
+		return err
 	}
 
-	var secretIDs []string
-	for _, identifier := range secretIdentifiers.Data {
-		secretIDs = append(secretIDs, identifier.ID)
-	}
+	return FetchAndStore(ctx, providers, sink)
+}
 
-	secrets, err := client.Secrets().GetByIDS(secretIDs)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving secrets: %w", err)
-	}
+// FetchAndStore fetches secrets from each provider in order, merging them so
+// that a later provider's keys override an earlier provider's, then writes
+// the result to sink.
+func FetchAndStore(ctx context.Context, providers []Provider, sink Sink) error {
+	merged := make(map[string]string)
 
-	return secrets, nil
-}
+	for _, provider := range providers {
+		fetched, err := provider.Fetch(ctx)
+		if err != nil {
+			return fmt.Errorf("secrets: %s: %w", provider.Name(), err)
+		}
 
-// SetEnvironmentVariables sets retrieved secrets as environment variables.
-func SetEnvironmentVariables(secrets *bws.SecretsResponse) error {
-	for _, secret := range secrets.Data {
-		if secret.Key == "" {
-			continue
+		for _, s := range fetched {
+			if s.Key == "" {
+				continue
+			}
+			merged[s.Key] = s.Value
 		}
+	}
 
-		if err := os.Setenv(secret.Key, secret.Value); err != nil {
-			return fmt.Errorf("error setting env var for key %s: %w", secret.Key, err)
+	for key, value := range merged {
+		if err := sink.Set(key, value); err != nil {
+			return fmt.Errorf("secrets: error setting %s: %w", key, err)
 		}
 	}
 
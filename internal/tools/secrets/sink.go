@@ -0,0 +1,66 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+)
+
+// EnvSink sets each secret as a process environment variable, matching the
+// package's original behavior.
+type EnvSink struct{}
+
+// Set implements Sink.
+func (EnvSink) Set(key, value string) error {
+	return os.Setenv(key, value)
+}
+
+// MapSink collects secrets into an in-memory map instead of the process
+// environment.
+type MapSink map[string]string
+
+// Set implements Sink.
+func (m MapSink) Set(key, value string) error {
+	m[key] = value
+	return nil
+}
+
+// BindSink writes secrets directly onto the string fields of a struct, based
+// on each field's `secret:"KEY"` tag. Keys with no matching field are ignored.
+type BindSink struct {
+	target any
+}
+
+// Bind returns a Sink that writes secrets onto target's tagged fields.
+// target must be a pointer to a struct.
+func Bind(target any) (*BindSink, error) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("secrets: Bind target must be a pointer to a struct")
+	}
+
+	return &BindSink{target: target}, nil
+}
+
+// Set implements Sink.
+func (b *BindSink) Set(key, value string) error {
+	elem := reflect.ValueOf(b.target).Elem()
+	t := elem.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("secret") != key {
+			continue
+		}
+
+		field := elem.Field(i)
+		if field.Kind() != reflect.String || !field.CanSet() {
+			return fmt.Errorf("secrets: field %s for key %s must be a settable string", t.Field(i).Name, key)
+		}
+
+		field.SetString(value)
+		return nil
+	}
+
+	return nil
+}
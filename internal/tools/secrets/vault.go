@@ -0,0 +1,117 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+// VaultProvider fetches secrets from a HashiCorp Vault KV v2 mount, either
+// using a static token or AppRole authentication.
+type VaultProvider struct {
+	addr       string
+	mountPath  string
+	secretPath string
+
+	token    string
+	roleID   string
+	secretID string
+}
+
+// NewVaultProviderFromEnv builds a VaultProvider from VAULT_ADDR,
+// VAULT_SECRET_PATH, and either VAULT_TOKEN or VAULT_ROLE_ID/VAULT_SECRET_ID.
+// VAULT_MOUNT_PATH defaults to "secret".
+func NewVaultProviderFromEnv() (*VaultProvider, error) {
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		return nil, errors.New("VAULT_ADDR must be set")
+	}
+
+	secretPath := os.Getenv("VAULT_SECRET_PATH")
+	if secretPath == "" {
+		return nil, errors.New("VAULT_SECRET_PATH must be set")
+	}
+
+	mountPath := os.Getenv("VAULT_MOUNT_PATH")
+	if mountPath == "" {
+		mountPath = "secret"
+	}
+
+	token := os.Getenv("VAULT_TOKEN")
+	roleID := os.Getenv("VAULT_ROLE_ID")
+	secretID := os.Getenv("VAULT_SECRET_ID")
+
+	if token == "" && (roleID == "" || secretID == "") {
+		return nil, errors.New("either VAULT_TOKEN or VAULT_ROLE_ID and VAULT_SECRET_ID must be set")
+	}
+
+	return &VaultProvider{
+		addr:       addr,
+		mountPath:  mountPath,
+		secretPath: secretPath,
+		token:      token,
+		roleID:     roleID,
+		secretID:   secretID,
+	}, nil
+}
+
+// Name implements Provider.
+func (p *VaultProvider) Name() string {
+	return "vault"
+}
+
+// Fetch implements Provider.
+func (p *VaultProvider) Fetch(ctx context.Context) ([]Secret, error) {
+	client, err := vaultapi.NewClient(vaultapi.DefaultConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error creating vault client: %w", err)
+	}
+
+	if err := client.SetAddress(p.addr); err != nil {
+		return nil, fmt.Errorf("error setting vault address: %w", err)
+	}
+
+	token := p.token
+	if token == "" {
+		token, err = p.loginAppRole(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	client.SetToken(token)
+
+	secret, err := client.KVv2(p.mountPath).Get(ctx, p.secretPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading vault secret %s/%s: %w", p.mountPath, p.secretPath, err)
+	}
+
+	out := make([]Secret, 0, len(secret.Data))
+	for key, value := range secret.Data {
+		sv, ok := value.(string)
+		if !ok {
+			continue
+		}
+		out = append(out, Secret{Key: key, Value: sv})
+	}
+
+	return out, nil
+}
+
+// loginAppRole exchanges VAULT_ROLE_ID/VAULT_SECRET_ID for a client token.
+func (p *VaultProvider) loginAppRole(ctx context.Context, client *vaultapi.Client) (string, error) {
+	resp, err := client.Logical().WriteWithContext(ctx, "auth/approle/login", map[string]any{
+		"role_id":   p.roleID,
+		"secret_id": p.secretID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error logging in to vault via approle: %w", err)
+	}
+	if resp == nil || resp.Auth == nil {
+		return "", errors.New("vault approle login returned no auth info")
+	}
+
+	return resp.Auth.ClientToken, nil
+}
@@ -4,10 +4,15 @@
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"github.com/magefile/mage/mg"
 	"github.com/magefile/mage/sh"
@@ -21,6 +26,7 @@ var (
 	binaryName = "lrag"
 	binDir     = ".builds"
 	appData    = "./app_data"
+	imageRepo  = "lrag"
 )
 
 // --------------------------------------------------------------------------
@@ -59,6 +65,136 @@ func (Build) Darwin() error {
 	return build("darwin", "amd64")
 }
 
+// Build for the current platform's OS, arm64 arch.
+func (Build) Arm64() error {
+	fmt.Println("Building for the current platform (arm64)...")
+	return build(runtime.GOOS, "arm64")
+}
+
+// Build for Linux (arm64).
+func (Build) LinuxArm64() error {
+	fmt.Println("Building for Linux (arm64)...")
+	return build("linux", "arm64")
+}
+
+// Build a single-arch OCI image for linux/amd64, tagged lrag:linux-amd64.
+func (Build) Image() error {
+	return buildImage("amd64")
+}
+
+// Build and push a multi-arch manifest list for linux/amd64 and linux/arm64.
+// Requires IMAGE_REGISTRY: neither the docker nor the buildah image store can
+// hold a multi-arch manifest list locally, so there's nothing useful to build
+// without somewhere to push it. Use Build.Image for a local single-arch image.
+func (Build) ImageManifest() error {
+	registry := os.Getenv("IMAGE_REGISTRY")
+	if registry == "" {
+		return errors.New("IMAGE_REGISTRY must be set to push a multi-arch manifest list " +
+			"(try `mage build:image` for a local single-arch image instead)")
+	}
+
+	fmt.Println("Building multi-arch image manifest for linux/amd64 and linux/arm64...")
+
+	if err := build("linux", "amd64"); err != nil {
+		return err
+	}
+	if err := build("linux", "arm64"); err != nil {
+		return err
+	}
+
+	tag := imageTag(registry)
+
+	args := []string{
+		"buildx", "build",
+		"--platform", "linux/amd64,linux/arm64",
+		"-t", tag,
+		"--push",
+		".",
+	}
+
+	if err := sh.RunV("docker", args...); err == nil {
+		fmt.Printf("Successfully built and pushed manifest %s\n", tag)
+		return nil
+	}
+
+	fmt.Println("docker buildx unavailable or failed, falling back to buildah manifest...")
+	return buildManifestWithBuildah(tag)
+}
+
+// imageTag returns the tag to build the multi-arch manifest under, prefixed
+// with registry so the image is ready to push there.
+func imageTag(registry string) string {
+	return fmt.Sprintf("%s/%s:latest", strings.TrimSuffix(registry, "/"), imageRepo)
+}
+
+// buildImage builds the linux binary for arch, then builds a single-arch
+// image from it via podman, falling back to docker buildx if podman fails.
+func buildImage(arch string) error {
+	fmt.Printf("Building container image for linux/%s...\n", arch)
+
+	if err := build("linux", arch); err != nil {
+		return err
+	}
+
+	tag := fmt.Sprintf("%s:linux-%s", imageRepo, arch)
+	args := []string{
+		"build",
+		"--build-arg", "TARGETOS=linux",
+		"--build-arg", fmt.Sprintf("TARGETARCH=%s", arch),
+		"-t", tag,
+		".",
+	}
+
+	if err := sh.RunV("podman", args...); err == nil {
+		fmt.Printf("Successfully built %s with podman\n", tag)
+		return nil
+	}
+
+	fmt.Println("podman unavailable or failed, falling back to docker buildx...")
+	if err := sh.RunV("docker", append([]string{"buildx"}, args...)...); err != nil {
+		return fmt.Errorf("failed to build image %s: %w", tag, err)
+	}
+
+	fmt.Printf("Successfully built %s with docker buildx\n", tag)
+	return nil
+}
+
+// buildManifestWithBuildah assembles and pushes a multi-arch manifest list
+// using buildah, for environments without docker buildx.
+func buildManifestWithBuildah(tag string) error {
+	manifestName := "lrag-manifest"
+
+	if err := sh.RunV("buildah", "manifest", "create", manifestName); err != nil {
+		return fmt.Errorf("failed to create manifest %s: %w", manifestName, err)
+	}
+
+	for _, arch := range []string{"amd64", "arm64"} {
+		archTag := fmt.Sprintf("%s-%s", tag, arch)
+
+		err := sh.RunV("buildah", "build",
+			"--build-arg", "TARGETOS=linux",
+			"--build-arg", fmt.Sprintf("TARGETARCH=%s", arch),
+			"--arch", arch,
+			"-t", archTag,
+			".",
+		)
+		if err != nil {
+			return fmt.Errorf("failed to build %s: %w", archTag, err)
+		}
+
+		if err := sh.RunV("buildah", "manifest", "add", manifestName, archTag); err != nil {
+			return fmt.Errorf("failed to add %s to manifest %s: %w", archTag, manifestName, err)
+		}
+	}
+
+	if os.Getenv("IMAGE_REGISTRY") == "" {
+		fmt.Printf("Built local manifest %s (IMAGE_REGISTRY not set, not pushed)\n", manifestName)
+		return nil
+	}
+
+	return sh.RunV("buildah", "manifest", "push", "--all", manifestName, "docker://"+tag)
+}
+
 // Helper function to perform the build.
 func build(goos, goarch string) error {
 	// create the output directory if it doesn't exist
@@ -107,6 +243,81 @@ func (Clean) WipeData() error {
 	return os.RemoveAll(appData)
 }
 
+// --------------------------------------------------------------------------
+// Release functions
+
+// Release namespace
+type Release mg.Namespace
+
+// Compute a SHA256 digest for every artifact in .builds/ and write checksums.txt.
+func (Release) Checksums() error {
+	fmt.Println("Computing checksums for build artifacts...")
+
+	entries, err := os.ReadDir(binDir)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", binDir, err)
+	}
+
+	out, err := os.Create(filepath.Join(binDir, "checksums.txt"))
+	if err != nil {
+		return fmt.Errorf("failed to create checksums.txt: %w", err)
+	}
+	defer out.Close()
+
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == "checksums.txt" {
+			continue
+		}
+
+		sum, serr := sha256File(filepath.Join(binDir, entry.Name()))
+		if serr != nil {
+			return serr
+		}
+
+		if _, werr := fmt.Fprintf(out, "%s  %s\n", sum, entry.Name()); werr != nil {
+			return fmt.Errorf("failed to write checksums.txt: %w", werr)
+		}
+	}
+
+	fmt.Printf("Wrote %s\n", filepath.Join(binDir, "checksums.txt"))
+	return nil
+}
+
+// Sign the built container images with cosign, if COSIGN_KEY is set.
+func (Release) Sign() error {
+	key := os.Getenv("COSIGN_KEY")
+	if key == "" {
+		fmt.Println("COSIGN_KEY not set, skipping image signing.")
+		return nil
+	}
+
+	tag := imageRepo + ":latest"
+	fmt.Printf("Signing %s with cosign...\n", tag)
+	return sh.RunV("cosign", "sign", "--key", key, tag)
+}
+
+// Run the full release flow: checksums, then signing if configured.
+func (Release) All() error {
+	mg.Deps(Release.Checksums)
+	return Release{}.Sign()
+}
+
+// sha256File returns the hex-encoded SHA256 digest of the file at path.
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // --------------------------------------------------------------------------
 // Code functions
 